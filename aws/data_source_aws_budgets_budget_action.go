@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfbudgets "github.com/hashicorp/terraform-provider-aws/aws/internal/service/budgets"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/budgets/finder"
+)
+
+func dataSourceAwsBudgetsBudgetAction() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBudgetsBudgetActionRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"action_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"action_threshold": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_threshold_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action_threshold_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"action_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"approval_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"budget_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[^:\\]+$`), "The ':' and '\\' characters aren't allowed."),
+				),
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_action_definition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"groups": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"roles": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"users": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"ssm_action_definition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_sub_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance_ids": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"scp_action_definition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_ids": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"execution_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscriber": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subscription_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		accountID = meta.(*AWSClient).accountid
+	}
+
+	actionID := d.Get("action_id").(string)
+	budgetName := d.Get("budget_name").(string)
+
+	output, err := finder.ActionByAccountIDActionIDAndBudgetName(conn, accountID, actionID, budgetName)
+
+	if err != nil {
+		return fmt.Errorf("error reading Budget Action (%s): %w", tfbudgets.BudgetActionCreateResourceID(accountID, actionID, budgetName), err)
+	}
+
+	d.SetId(tfbudgets.BudgetActionCreateResourceID(accountID, actionID, budgetName))
+
+	d.Set("account_id", accountID)
+	d.Set("action_id", actionID)
+	d.Set("budget_name", budgetName)
+
+	if err := d.Set("action_threshold", flattenAwsBudgetsBudgetActionActionThreshold(output.ActionThreshold)); err != nil {
+		return fmt.Errorf("error setting action_threshold: %w", err)
+	}
+
+	d.Set("action_type", output.ActionType)
+	d.Set("approval_model", output.ApprovalModel)
+
+	if err := d.Set("definition", flattenAwsBudgetsBudgetActionDefinition(output.Definition)); err != nil {
+		return fmt.Errorf("error setting definition: %w", err)
+	}
+
+	d.Set("execution_role_arn", output.ExecutionRoleArn)
+	d.Set("notification_type", output.NotificationType)
+	d.Set("status", output.Status)
+
+	if err := d.Set("subscriber", flattenAwsBudgetsBudgetActionSubscriber(output.Subscribers)); err != nil {
+		return fmt.Errorf("error setting subscriber: %w", err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "budgets",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("budget/%s/action/%s", budgetName, actionID),
+	}
+	d.Set("arn", arn.String())
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSBudgetsBudgetActionDataSource_basic(t *testing.T) {
+	var action budgets.Action
+	resourceName := "aws_budgets_budget_action.test"
+	dataSourceName := "data.aws_budgets_budget_action.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, budgets.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBudgetsBudgetActionExists(resourceName, &action),
+					resource.TestCheckResourceAttrPair(resourceName, "action_id", dataSourceName, "action_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "budget_name", dataSourceName, "budget_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "action_threshold.#", dataSourceName, "action_threshold.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", dataSourceName, "execution_role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_type", dataSourceName, "notification_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "status", dataSourceName, "status"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetActionDataSourceConfig(rName string) string {
+	return composeConfig(testAccAWSBudgetsBudgetActionConfig_basic(rName), `
+data "aws_budgets_budget_action" "test" {
+  account_id  = aws_budgets_budget_action.test.account_id
+  budget_name = aws_budgets_budget_action.test.budget_name
+  action_id   = aws_budgets_budget_action.test.action_id
+}
+`)
+}
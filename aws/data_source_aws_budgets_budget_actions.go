@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsBudgetsBudgetActions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBudgetsBudgetActionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"budget_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[^:\\]+$`), "The ':' and '\\' characters aren't allowed."),
+				),
+			},
+			"action_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action_threshold": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_threshold_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"action_threshold_value": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"action_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"approval_model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"budget_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsBudgetsBudgetActionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		accountID = meta.(*AWSClient).accountid
+	}
+
+	budgetName := d.Get("budget_name").(string)
+
+	var summaries []*budgets.Action
+
+	if budgetName != "" {
+		input := &budgets.DescribeBudgetActionsForBudgetInput{
+			AccountId:  aws.String(accountID),
+			BudgetName: aws.String(budgetName),
+		}
+
+		err := conn.DescribeBudgetActionsForBudgetPages(input, func(page *budgets.DescribeBudgetActionsForBudgetOutput, lastPage bool) bool {
+			summaries = append(summaries, page.Actions...)
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error describing Budget Actions for Budget (%s): %w", budgetName, err)
+		}
+	} else {
+		input := &budgets.DescribeBudgetActionsForAccountInput{
+			AccountId: aws.String(accountID),
+		}
+
+		err := conn.DescribeBudgetActionsForAccountPages(input, func(page *budgets.DescribeBudgetActionsForAccountOutput, lastPage bool) bool {
+			summaries = append(summaries, page.Actions...)
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error describing Budget Actions for Account (%s): %w", accountID, err)
+		}
+	}
+
+	actionIDs := make([]string, 0, len(summaries))
+	actions := make([]map[string]interface{}, 0, len(summaries))
+
+	for _, summary := range summaries {
+		actionIDs = append(actionIDs, aws.StringValue(summary.ActionId))
+
+		actions = append(actions, map[string]interface{}{
+			"action_id":         aws.StringValue(summary.ActionId),
+			"action_threshold":  flattenAwsBudgetsBudgetActionActionThreshold(summary.ActionThreshold),
+			"action_type":       aws.StringValue(summary.ActionType),
+			"approval_model":    aws.StringValue(summary.ApprovalModel),
+			"budget_name":       aws.StringValue(summary.BudgetName),
+			"notification_type": aws.StringValue(summary.NotificationType),
+			"status":            aws.StringValue(summary.Status),
+		})
+	}
+
+	id := accountID
+	if budgetName != "" {
+		id = fmt.Sprintf("%s/%s", accountID, budgetName)
+	}
+	d.SetId(id)
+	d.Set("account_id", accountID)
+
+	if err := d.Set("action_ids", actionIDs); err != nil {
+		return fmt.Errorf("error setting action_ids: %w", err)
+	}
+
+	if err := d.Set("actions", actions); err != nil {
+		return fmt.Errorf("error setting actions: %w", err)
+	}
+
+	return nil
+}
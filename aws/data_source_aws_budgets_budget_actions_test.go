@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSBudgetsBudgetActionsDataSource_basic(t *testing.T) {
+	var action budgets.Action
+	resourceName := "aws_budgets_budget_action.test"
+	dataSourceName := "data.aws_budgets_budget_actions.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, budgets.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBudgetsBudgetActionExists(resourceName, &action),
+					resource.TestCheckResourceAttr(dataSourceName, "action_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "action_id", dataSourceName, "action_ids.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "budget_name", dataSourceName, "actions.0.budget_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "status", dataSourceName, "actions.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetActionsDataSourceConfig(rName string) string {
+	return composeConfig(testAccAWSBudgetsBudgetActionConfig_basic(rName), `
+data "aws_budgets_budget_actions" "test" {
+  account_id  = aws_budgets_budget_action.test.account_id
+  budget_name = aws_budgets_budget_action.test.budget_name
+}
+`)
+}
@@ -1,14 +1,18 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	tfbudgets "github.com/hashicorp/terraform-provider-aws/aws/internal/service/budgets"
@@ -20,13 +24,13 @@ import (
 
 func resourceAwsBudgetsBudgetAction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsBudgetsBudgetActionCreate,
-		Read:   resourceAwsBudgetsBudgetActionRead,
-		Update: resourceAwsBudgetsBudgetActionUpdate,
-		Delete: resourceAwsBudgetsBudgetActionDelete,
+		CreateContext: resourceAwsBudgetsBudgetActionCreate,
+		Read:          resourceAwsBudgetsBudgetActionRead,
+		UpdateContext: resourceAwsBudgetsBudgetActionUpdate,
+		Delete:        resourceAwsBudgetsBudgetActionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsBudgetsBudgetActionImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -182,6 +186,11 @@ func resourceAwsBudgetsBudgetAction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"warn_on_immediate_execution": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"subscriber": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -207,7 +216,34 @@ func resourceAwsBudgetsBudgetAction() *schema.Resource {
 	}
 }
 
-func resourceAwsBudgetsBudgetActionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsBudgetsBudgetActionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+
+	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(id)
+
+	if err != nil {
+		parts := strings.SplitN(id, "/", 2)
+
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("unexpected format for ID (%q), expected accountID:actionID:budgetName or budgetName/actionID", id)
+		}
+
+		budgetName = parts[0]
+		actionID = parts[1]
+		accountID = meta.(*AWSClient).accountid
+	}
+
+	d.SetId(tfbudgets.BudgetActionCreateResourceID(accountID, actionID, budgetName))
+	d.Set("account_id", accountID)
+	d.Set("action_id", actionID)
+	d.Set("budget_name", budgetName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsBudgetsBudgetActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	conn := meta.(*AWSClient).budgetconn
 
 	accountID := d.Get("account_id").(string)
@@ -215,12 +251,18 @@ func resourceAwsBudgetsBudgetActionCreate(d *schema.ResourceData, meta interface
 		accountID = meta.(*AWSClient).accountid
 	}
 
+	actionThreshold := expandAwsBudgetsBudgetActionActionThreshold(d.Get("action_threshold").([]interface{}))
+	budgetName := d.Get("budget_name").(string)
+	notificationType := d.Get("notification_type").(string)
+
+	diags = append(diags, resourceAwsBudgetsBudgetActionImmediateExecutionWarning(conn, d, accountID, budgetName, notificationType, actionThreshold)...)
+
 	input := &budgets.CreateBudgetActionInput{
 		AccountId:        aws.String(accountID),
-		ActionThreshold:  expandAwsBudgetsBudgetActionActionThreshold(d.Get("action_threshold").([]interface{})),
+		ActionThreshold:  actionThreshold,
 		ActionType:       aws.String(d.Get("action_type").(string)),
 		ApprovalModel:    aws.String(d.Get("approval_model").(string)),
-		BudgetName:       aws.String(d.Get("budget_name").(string)),
+		BudgetName:       aws.String(budgetName),
 		Definition:       expandAwsBudgetsBudgetActionActionDefinition(d.Get("definition").([]interface{})),
 		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
 		NotificationType: aws.String(d.Get("notification_type").(string)),
@@ -233,20 +275,24 @@ func resourceAwsBudgetsBudgetActionCreate(d *schema.ResourceData, meta interface
 	}, budgets.ErrCodeAccessDeniedException)
 
 	if err != nil {
-		return fmt.Errorf("error creating Budget Action: %w", err)
+		return append(diags, diag.FromErr(fmt.Errorf("error creating Budget Action: %w", err))...)
 	}
 
 	output := outputRaw.(*budgets.CreateBudgetActionOutput)
 	actionID := aws.StringValue(output.ActionId)
-	budgetName := aws.StringValue(output.BudgetName)
+	budgetName = aws.StringValue(output.BudgetName)
 
 	d.SetId(tfbudgets.BudgetActionCreateResourceID(accountID, actionID, budgetName))
 
 	if _, err := waiter.ActionAvailable(conn, accountID, actionID, budgetName); err != nil {
-		return fmt.Errorf("error waiting for Budget Action (%s) to create: %w", d.Id(), err)
+		return append(diags, diag.FromErr(fmt.Errorf("error waiting for Budget Action (%s) to create: %w", d.Id(), err))...)
+	}
+
+	if err := resourceAwsBudgetsBudgetActionRead(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	return resourceAwsBudgetsBudgetActionRead(d, meta)
+	return diags
 }
 
 func resourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
@@ -304,13 +350,15 @@ func resourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceAwsBudgetsBudgetActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsBudgetsBudgetActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	conn := meta.(*AWSClient).budgetconn
 
 	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(d.Id())
 
 	if err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 
 	input := &budgets.UpdateBudgetActionInput{
@@ -319,8 +367,10 @@ func resourceAwsBudgetsBudgetActionUpdate(d *schema.ResourceData, meta interface
 		BudgetName: aws.String(budgetName),
 	}
 
+	actionThreshold := expandAwsBudgetsBudgetActionActionThreshold(d.Get("action_threshold").([]interface{}))
+
 	if d.HasChange("action_threshold") {
-		input.ActionThreshold = expandAwsBudgetsBudgetActionActionThreshold(d.Get("action_threshold").([]interface{}))
+		input.ActionThreshold = actionThreshold
 	}
 
 	if d.HasChange("approval_model") {
@@ -343,18 +393,26 @@ func resourceAwsBudgetsBudgetActionUpdate(d *schema.ResourceData, meta interface
 		input.Subscribers = expandAwsBudgetsBudgetActionSubscriber(d.Get("subscriber").(*schema.Set))
 	}
 
+	if d.HasChange("action_threshold") || d.HasChange("approval_model") || d.HasChange("notification_type") {
+		diags = append(diags, resourceAwsBudgetsBudgetActionImmediateExecutionWarning(conn, d, accountID, budgetName, d.Get("notification_type").(string), actionThreshold)...)
+	}
+
 	log.Printf("[DEBUG] Updating Budget Action: %s", input)
 	_, err = conn.UpdateBudgetAction(input)
 
 	if err != nil {
-		return fmt.Errorf("error updating Budget Action (%s): %w", d.Id(), err)
+		return append(diags, diag.FromErr(fmt.Errorf("error updating Budget Action (%s): %w", d.Id(), err))...)
 	}
 
 	if _, err := waiter.ActionAvailable(conn, accountID, actionID, budgetName); err != nil {
-		return fmt.Errorf("error waiting for Budget Action (%s) to update: %w", d.Id(), err)
+		return append(diags, diag.FromErr(fmt.Errorf("error waiting for Budget Action (%s) to update: %w", d.Id(), err))...)
 	}
 
-	return resourceAwsBudgetsBudgetActionRead(d, meta)
+	if err := resourceAwsBudgetsBudgetActionRead(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
 }
 
 func resourceAwsBudgetsBudgetActionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -384,6 +442,152 @@ func resourceAwsBudgetsBudgetActionDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+// resourceAwsBudgetsBudgetActionImmediateExecutionWarning returns an apply-time
+// diagnostic warning when an AUTOMATIC Budget Action would fire the moment it
+// is created or updated, since such actions can revoke IAM policies, stop EC2
+// instances, or attach SCPs without any further user confirmation.
+//
+// There is no SDKv2 mechanism for a CustomizeDiff to surface a diagnostic the
+// user would actually see in `terraform plan` output (CustomizeDiff can only
+// return an error, which would abort the plan), so this check is only run
+// from Create/Update, where diag.Diagnostics are rendered to the user.
+func resourceAwsBudgetsBudgetActionImmediateExecutionWarning(conn *budgets.Budgets, d *schema.ResourceData, accountID, budgetName, notificationType string, actionThreshold *budgets.ActionThreshold) diag.Diagnostics {
+	if !d.Get("warn_on_immediate_execution").(bool) {
+		return nil
+	}
+
+	if d.Get("approval_model").(string) != budgets.ApprovalModelAutomatic {
+		return nil
+	}
+
+	willExecute, err := resourceAwsBudgetsBudgetActionWillExecuteImmediately(conn, accountID, budgetName, notificationType, actionThreshold)
+
+	if err != nil {
+		log.Printf("[WARN] error checking whether Budget Action would execute immediately: %s", err)
+		return nil
+	}
+
+	if !willExecute {
+		return nil
+	}
+
+	targets := resourceAwsBudgetsBudgetActionDefinitionTargets(expandAwsBudgetsBudgetActionActionDefinition(d.Get("definition").([]interface{})))
+
+	spendKind := "actual"
+	if notificationType == budgets.NotificationTypeForecasted {
+		spendKind = "forecasted"
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Budget Action will execute immediately on apply",
+			Detail: fmt.Sprintf(
+				"The %s spend for budget %q already meets or exceeds the configured action_threshold and approval_model is AUTOMATIC, "+
+					"so this action will run as soon as it is applied, affecting: %s",
+				spendKind, budgetName, strings.Join(targets, ", "),
+			),
+		},
+	}
+}
+
+// resourceAwsBudgetsBudgetActionWillExecuteImmediately compares a budget's
+// current spend against the given action threshold to determine whether an
+// AUTOMATIC action attached to it would fire immediately. A FORECASTED action
+// fires off of the budget's forecasted spend rather than its actual spend, so
+// notificationType selects which of the two CalculatedSpend figures applies.
+func resourceAwsBudgetsBudgetActionWillExecuteImmediately(conn *budgets.Budgets, accountID, budgetName, notificationType string, actionThreshold *budgets.ActionThreshold) (bool, error) {
+	if actionThreshold == nil {
+		return false, nil
+	}
+
+	output, err := conn.DescribeBudget(&budgets.DescribeBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	})
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error describing Budget (%s): %w", budgetName, err)
+	}
+
+	if output.Budget == nil || output.Budget.CalculatedSpend == nil {
+		return false, nil
+	}
+
+	var spendAmount *string
+	if notificationType == budgets.NotificationTypeForecasted {
+		if output.Budget.CalculatedSpend.ForecastedSpend == nil {
+			return false, nil
+		}
+		spendAmount = output.Budget.CalculatedSpend.ForecastedSpend.Amount
+	} else {
+		if output.Budget.CalculatedSpend.ActualSpend == nil {
+			return false, nil
+		}
+		spendAmount = output.Budget.CalculatedSpend.ActualSpend.Amount
+	}
+
+	spend, err := strconv.ParseFloat(aws.StringValue(spendAmount), 64)
+
+	if err != nil {
+		return false, fmt.Errorf("error parsing Budget (%s) calculated spend: %w", budgetName, err)
+	}
+
+	switch aws.StringValue(actionThreshold.ActionThresholdType) {
+	case budgets.ThresholdTypePercentage:
+		if output.Budget.BudgetLimit == nil {
+			return false, nil
+		}
+
+		limit, err := strconv.ParseFloat(aws.StringValue(output.Budget.BudgetLimit.Amount), 64)
+
+		if err != nil {
+			return false, fmt.Errorf("error parsing Budget (%s) limit: %w", budgetName, err)
+		}
+
+		if limit == 0 {
+			return false, nil
+		}
+
+		return (spend/limit)*100 >= aws.Float64Value(actionThreshold.ActionThresholdValue), nil
+	case budgets.ThresholdTypeAbsoluteValue:
+		return spend >= aws.Float64Value(actionThreshold.ActionThresholdValue), nil
+	default:
+		return false, nil
+	}
+}
+
+// resourceAwsBudgetsBudgetActionDefinitionTargets flattens the principals,
+// instances, and organizational units that a Budget Action definition would
+// affect, for use in diagnostic messages.
+func resourceAwsBudgetsBudgetActionDefinitionTargets(definition *budgets.Definition) []string {
+	if definition == nil {
+		return nil
+	}
+
+	var targets []string
+
+	if iamDefinition := definition.IamActionDefinition; iamDefinition != nil {
+		targets = append(targets, aws.StringValueSlice(iamDefinition.Users)...)
+		targets = append(targets, aws.StringValueSlice(iamDefinition.Roles)...)
+		targets = append(targets, aws.StringValueSlice(iamDefinition.Groups)...)
+	}
+
+	if ssmDefinition := definition.SsmActionDefinition; ssmDefinition != nil {
+		targets = append(targets, aws.StringValueSlice(ssmDefinition.InstanceIds)...)
+	}
+
+	if scpDefinition := definition.ScpActionDefinition; scpDefinition != nil {
+		targets = append(targets, aws.StringValueSlice(scpDefinition.TargetIds)...)
+	}
+
+	return targets
+}
+
 func expandAwsBudgetsBudgetActionActionThreshold(l []interface{}) *budgets.ActionThreshold {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2Tags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TagsCreate,
+		Read:   resourceAwsEc2TagsRead,
+		Update: resourceAwsEc2TagsUpdate,
+		Delete: resourceAwsEc2TagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2TagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TagsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	identifier := d.Get("resource_id").(string)
+
+	if err := keyvaluetags.Ec2UpdateTags(conn, identifier, nil, d.Get("tags").(map[string]interface{})); err != nil {
+		return fmt.Errorf("error creating EC2 Tags (%s): %w", identifier, err)
+	}
+
+	d.SetId(identifier)
+
+	return resourceAwsEc2TagsRead(d, meta)
+}
+
+func resourceAwsEc2TagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	identifier := d.Id()
+
+	tags, err := keyvaluetags.Ec2ListTags(conn, identifier)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Tags (%s) not found, removing from state", identifier)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for EC2 Resource (%s): %w", identifier, err)
+	}
+
+	actual := tags.IgnoreAws().Map()
+
+	// Only track the keys declared in config (or seeded by Import) so that
+	// tags managed by other means on the same resource are left alone.
+	managed := map[string]interface{}{}
+	for key := range d.Get("tags").(map[string]interface{}) {
+		if value, ok := actual[key]; ok {
+			managed[key] = value
+		}
+	}
+
+	d.Set("resource_id", identifier)
+
+	if err := d.Set("tags", managed); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	identifier := d.Get("resource_id").(string)
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, identifier, o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Tags (%s): %w", identifier, err)
+		}
+	}
+
+	return resourceAwsEc2TagsRead(d, meta)
+}
+
+func resourceAwsEc2TagsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	identifier := d.Get("resource_id").(string)
+
+	if err := keyvaluetags.Ec2UpdateTags(conn, identifier, d.Get("tags").(map[string]interface{}), nil); err != nil {
+		return fmt.Errorf("error deleting EC2 Tags (%s): %w", identifier, err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TagsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ",")
+
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%q), expected resource_id,key1,key2,...", d.Id())
+	}
+
+	identifier := parts[0]
+	tags := make(map[string]interface{}, len(parts)-1)
+	for _, key := range parts[1:] {
+		tags[key] = ""
+	}
+
+	d.SetId(identifier)
+	d.Set("resource_id", identifier)
+	if err := d.Set("tags", tags); err != nil {
+		return nil, fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}